@@ -323,6 +323,13 @@ func goToolArch(arch string, cc string, subcmd string, args ...string) *exec.Cmd
 //
 // "tests" also includes static analysis tools such as vet.
 
+// forkedVendorPackages are vendored packages carrying local changes, see
+// the comments in vendor/vendor.json. They are skipped when expanding
+// "./...", so their tests are listed explicitly.
+var forkedVendorPackages = []string{
+	"./vendor/github.com/dexon-foundation/dexon-consensus/common",
+}
+
 func doTest(cmdline []string) {
 	coverage := flag.Bool("coverage", false, "Whether to record code coverage")
 	flag.CommandLine.Parse(cmdline)
@@ -333,6 +340,9 @@ func doTest(cmdline []string) {
 		packages = flag.CommandLine.Args()
 	}
 	packages = build.ExpandPackagesNoVendor(packages)
+	if len(flag.CommandLine.Args()) == 0 {
+		packages = append(packages, forkedVendorPackages...)
+	}
 
 	packageForLegacyEvm := []string{}
 	for i := 0; i < len(packages); i++ {